@@ -0,0 +1,30 @@
+module github.com/shanxiaowei2025/zhongyue-weixin
+
+go 1.23
+
+// github.com/NICEXAI/WeWorkFinanceSDK 通过 cgo 链接 libWeWorkFinanceSdk_C.so。
+// 该模块目前无法从 proxy.golang.org 获取（所有版本返回 403），需在能访问源仓库的环境中执行
+//   GOPROXY=direct go get github.com/NICEXAI/WeWorkFinanceSDK@<版本>
+// 写入下方 require 和 go.sum 后再构建（见 WEIXIN_MSG_ARCHIVE_GUIDE.md）
+require (
+	github.com/gorilla/websocket v1.5.3
+	github.com/prometheus/client_golang v1.19.1
+	github.com/segmentio/kafka-go v0.4.51
+	github.com/tidwall/gjson v1.19.0
+	github.com/tidwall/sjson v1.2.5
+	golang.org/x/sync v0.7.0
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/klauspost/compress v1.15.9 // indirect
+	github.com/pierrec/lz4/v4 v4.1.15 // indirect
+	github.com/prometheus/client_model v0.5.0 // indirect
+	github.com/prometheus/common v0.48.0 // indirect
+	github.com/prometheus/procfs v0.12.0 // indirect
+	github.com/tidwall/match v1.1.1 // indirect
+	github.com/tidwall/pretty v1.2.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	google.golang.org/protobuf v1.33.0 // indirect
+)